@@ -11,9 +11,11 @@ import (
 // StakerAddress represents chain-specific address of the staker.
 type StakerAddress []byte
 
-// GroupMemberIndex is an index of a threshold relay group member.
-// Maximum value accepted by the chain is 255.
-type GroupMemberIndex = uint8
+// GroupMemberIndex is an index of a threshold relay group member. Indexes
+// start with 1 and the maximum value accepted by the chain is 255. It is
+// a distinct type, so a 1-based member index can not be mixed with a raw
+// 0-based position of the member in the group.
+type GroupMemberIndex uint8
 
 // RelayEntryInterface defines the subset of the relay chain interface that
 // pertains specifically to submission and retrieval of relay requests and
@@ -21,7 +21,7 @@ var logger = log.Logger("keep-dkg")
 // ExecuteDKG runs the full distributed key generation lifecycle.
 func ExecuteDKG(
 	seed *big.Int,
-	index uint8, // starts with 0
+	playerIndex group.MemberIndex,
 	groupSize int,
 	dishonestThreshold int,
 	membershipValidator group.MembershipValidator,
@@ -31,9 +31,6 @@ func ExecuteDKG(
 	signing chain.Signing,
 	channel net.BroadcastChannel,
 ) (*ThresholdSigner, error) {
-	// The staker index should begin with 1
-	playerIndex := group.MemberIndex(index + 1)
-
 	gjkr.RegisterUnmarshallers(channel)
 	dkgResult.RegisterUnmarshallers(channel)
 
@@ -155,7 +152,7 @@ func decideMemberFate(
 
 	// If member is considered as misbehaved, it could not stay in the group.
 	for _, misbehaved := range dkgResultEvent.Misbehaved {
-		if playerIndex == misbehaved {
+		if playerIndex == group.MemberIndex(misbehaved) {
 			return fmt.Errorf(
 				"[member:%v] could not stay in the group because "+
 					"member is considered as misbehaving",
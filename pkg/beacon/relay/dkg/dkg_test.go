@@ -97,7 +97,7 @@ func TestDecideMemberFate_MemberIsMisbehaved(t *testing.T) {
 
 	dkgResultChannel <- &event.DKGResultSubmission{
 		GroupPublicKey: groupPublicKey.Marshal(),
-		Misbehaved:     []byte{playerIndex},
+		Misbehaved:     []byte{byte(playerIndex)},
 	}
 
 	err := decideMemberFate(
@@ -14,6 +14,14 @@ func TestConvertResult(t *testing.T) {
 	publicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(2))
 	marshalledPublicKey := publicKey.Marshal()
 
+	newDkgGroup := func() *group.Group {
+		dkgGroup, err := group.NewDkgGroup(32, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dkgGroup
+	}
+
 	var tests = map[string]struct {
 		disqualifiedMemberIDs []group.MemberIndex
 		inactiveMemberIDs     []group.MemberIndex
@@ -25,7 +33,7 @@ func TestConvertResult(t *testing.T) {
 			inactiveMemberIDs:     []group.MemberIndex{},
 			gjkrResult: &gjkr.Result{
 				GroupPublicKey: nil,
-				Group:          group.NewDkgGroup(32, 64),
+				Group:          newDkgGroup(),
 			},
 			expectedResult: &relayChain.DKGResult{
 				GroupPublicKey: []byte{},
@@ -37,7 +45,7 @@ func TestConvertResult(t *testing.T) {
 			inactiveMemberIDs:     []group.MemberIndex{},
 			gjkrResult: &gjkr.Result{
 				GroupPublicKey: publicKey,
-				Group:          group.NewDkgGroup(32, 64),
+				Group:          newDkgGroup(),
 			},
 			expectedResult: &relayChain.DKGResult{
 				GroupPublicKey: marshalledPublicKey,
@@ -49,7 +57,7 @@ func TestConvertResult(t *testing.T) {
 			inactiveMemberIDs:     []group.MemberIndex{5, 3, 50},
 			gjkrResult: &gjkr.Result{
 				GroupPublicKey: publicKey,
-				Group:          group.NewDkgGroup(32, 64),
+				Group:          newDkgGroup(),
 			},
 			expectedResult: &relayChain.DKGResult{
 				GroupPublicKey: marshalledPublicKey,
@@ -61,7 +69,7 @@ func TestConvertResult(t *testing.T) {
 			inactiveMemberIDs:     []group.MemberIndex{5},
 			gjkrResult: &gjkr.Result{
 				GroupPublicKey: publicKey,
-				Group:          group.NewDkgGroup(32, 64),
+				Group:          newDkgGroup(),
 			},
 			expectedResult: &relayChain.DKGResult{
 				GroupPublicKey: marshalledPublicKey,
@@ -73,7 +81,7 @@ func TestConvertResult(t *testing.T) {
 			inactiveMemberIDs:     []group.MemberIndex{},
 			gjkrResult: &gjkr.Result{
 				GroupPublicKey: publicKey,
-				Group:          group.NewDkgGroup(32, 64),
+				Group:          newDkgGroup(),
 			},
 			expectedResult: &relayChain.DKGResult{
 				GroupPublicKey: marshalledPublicKey,
@@ -1,25 +1,11 @@
 package result
 
 import (
-	"fmt"
-
 	"github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result/gen/pb"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 )
 
-// MemberIndex is represented as uint8 in gjkr. Protobuf does not have uint8
-// type so we are using uint32. When unmarshalling message, we need to make
-// sure we do not overflow.
-const maxMemberIndex = 255
-
-func validateMemberIndex(protoIndex uint32) error {
-	if protoIndex > maxMemberIndex {
-		return fmt.Errorf("Invalid member index value: [%v]", protoIndex)
-	}
-	return nil
-}
-
 // Type returns a string describing a DKGResultHashSignatureMessage type for
 // marshalling purposes.
 func (d *DKGResultHashSignatureMessage) Type() string {
@@ -45,7 +31,7 @@ func (d *DKGResultHashSignatureMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderIndex); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderIndex); err != nil {
 		return err
 	}
 	d.senderIndex = group.MemberIndex(pbMsg.SenderIndex)
@@ -181,5 +181,6 @@ func (sm *SigningMember) IsSenderValid(
 	senderID group.MemberIndex,
 	senderPublicKey []byte,
 ) bool {
-	return sm.membershipValidator.IsValidMembership(senderID, senderPublicKey)
+	return sm.group.ValidateMemberIndex(senderID) == nil &&
+		sm.membershipValidator.IsValidMembership(senderID, senderPublicKey)
 }
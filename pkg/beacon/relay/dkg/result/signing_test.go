@@ -311,7 +311,10 @@ func initializeSigningMembers(groupSize int) ([]*SigningMember, []chain.Handle,
 	dishonestThreshold := groupSize - honestThreshold
 	minimumStake := big.NewInt(200)
 
-	dkgGroup := group.NewDkgGroup(dishonestThreshold, groupSize)
+	dkgGroup, err := group.NewDkgGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	members := make([]*SigningMember, groupSize)
 	chainHandles := make([]chain.Handle, groupSize)
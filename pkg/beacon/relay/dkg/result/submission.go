@@ -168,8 +168,13 @@ func (sm *SubmittingMember) waitForSubmissionEligibility(
 	startBlockHeight uint64,
 	blockStep uint64,
 ) (<-chan uint64, error) {
+	position, err := group.MemberPosition(sm.index)
+	if err != nil {
+		return nil, err
+	}
+
 	// T_init + (member_index - 1) * T_step
-	blockWaitTime := (uint64(sm.index) - 1) * blockStep
+	blockWaitTime := uint64(position) * blockStep
 
 	eligibleBlockHeight := startBlockHeight + blockWaitTime
 	logger.Infof(
@@ -1,24 +1,10 @@
 package entry
 
 import (
-	"fmt"
-
 	"github.com/keep-network/keep-core/pkg/beacon/relay/entry/gen/pb"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 )
 
-// MemberIndex is represented as uint8 in gjkr. Protobuf does not have uint8
-// type so we are using uint32. When unmarshalling message, we need to make
-// sure we do not overflow.
-const maxMemberIndex = 255
-
-func validateMemberIndex(protoIndex uint32) error {
-	if protoIndex > maxMemberIndex {
-		return fmt.Errorf("Invalid member index value: [%v]", protoIndex)
-	}
-	return nil
-}
-
 // Type returns a string describing a SignatureShareMessage's type.
 func (*SignatureShareMessage) Type() string {
 	return "relay/signature/share"
@@ -44,7 +30,7 @@ func (ssm *SignatureShareMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbSignatureShare.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbSignatureShare.SenderID); err != nil {
 		return err
 	}
 	ssm.senderID = group.MemberIndex(pbSignatureShare.SenderID)
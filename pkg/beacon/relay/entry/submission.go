@@ -102,8 +102,13 @@ func (res *relayEntrySubmitter) waitForSubmissionEligibility(
 	startBlockHeight uint64,
 	blockStep uint64,
 ) (<-chan uint64, error) {
+	position, err := group.MemberPosition(res.index)
+	if err != nil {
+		return nil, err
+	}
+
 	// (member_index - 1) * T_step
-	blockWaitTime := (uint64(res.index) - 1) * blockStep
+	blockWaitTime := uint64(position) * blockStep
 
 	eligibleBlockHeight := startBlockHeight + blockWaitTime
 	logger.Infof(
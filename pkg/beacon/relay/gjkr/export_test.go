@@ -6,6 +6,7 @@ import (
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral/zkp"
 )
 
 func (epkm *EphemeralPublicKeyMessage) SetSenderId(
@@ -21,6 +22,24 @@ func (epkm *EphemeralPublicKeyMessage) SetPublicKey(
 	epkm.ephemeralPublicKeys[memberIndex] = publicKey
 }
 
+func (epkm *EphemeralPublicKeyMessage) SetPublicKeyProof(
+	memberIndex group.MemberIndex,
+	proof *zkp.DiscreteLogProof,
+) {
+	epkm.ephemeralPublicKeyProofs[memberIndex] = proof
+}
+
+func EphemeralPublicKeyProofContext(
+	seed *big.Int,
+	senderID group.MemberIndex,
+	receiverID group.MemberIndex,
+) []byte {
+	return newProtocolParameters(seed).ephemeralPublicKeyProofContext(
+		senderID,
+		receiverID,
+	)
+}
+
 func (epkm *EphemeralPublicKeyMessage) GetPublicKey(
 	memberIndex group.MemberIndex,
 ) *ephemeral.PublicKey {
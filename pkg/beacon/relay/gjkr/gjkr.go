@@ -81,13 +81,30 @@ func Execute(
 
 	lastState, endBlockHeight, err := stateMachine.Execute(startBlockHeight)
 	if err != nil {
+		wipeSecrets(lastState)
 		return nil, 0, err
 	}
 
 	finalizationState, ok := lastState.(*finalizationState)
 	if !ok {
+		wipeSecrets(lastState)
 		return nil, 0, fmt.Errorf("execution ended on state: %T", lastState)
 	}
 
 	return finalizationState.result(), endBlockHeight, nil
 }
+
+// secretsHoldingState is a key generation state whose member holds private
+// values generated during the protocol execution.
+type secretsHoldingState interface {
+	wipeSecrets()
+}
+
+// wipeSecrets wipes private values held by the member of the given state.
+// It is used when the protocol execution stops before reaching the
+// finalization state, which wipes secrets on a successful execution.
+func wipeSecrets(lastState keyGenerationState) {
+	if holdingState, ok := lastState.(secretsHoldingState); ok {
+		holdingState.wipeSecrets()
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/internal/dkgtest"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral/zkp"
 )
 
 func TestExecute_HappyPath(t *testing.T) {
@@ -272,6 +273,47 @@ func TestExecute_DQ_member1_invalidMessage_phase2(t *testing.T) {
 // Phase 4 test case - a member sends an invalid member commitments message.
 // Message payload doesn't contain a correct number of commitments.
 // Sender of the invalid message is disqualified by all of the receivers.
+func TestExecute_DQ_member1_invalidPublicKeyProof_phase2(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 5
+	honestThreshold := 3
+	seed := dkgtest.RandomSeed(t)
+
+	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		publicKeyMessage, ok := msg.(*gjkr.EphemeralPublicKeyMessage)
+		if ok && publicKeyMessage.SenderID() == group.MemberIndex(1) {
+			// member 1 replaces its key for member 3 but keeps the proof
+			// generated for the original key
+			keyPair, err := ephemeral.GenerateKeyPair()
+			if err != nil {
+				t.Fatal(err)
+			}
+			publicKeyMessage.SetPublicKey(
+				group.MemberIndex(3),
+				keyPair.PublicKey,
+			)
+			return publicKeyMessage
+		}
+
+		return msg
+	}
+
+	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dkgtest.AssertDkgResultPublished(t, result)
+	dkgtest.AssertSuccessfulSignersCount(t, result, groupSize-1)
+	dkgtest.AssertSuccessfulSigners(t, result, []group.MemberIndex{2, 3, 4, 5}...)
+	dkgtest.AssertMemberFailuresCount(t, result, 1)
+	dkgtest.AssertSamePublicKey(t, result)
+	dkgtest.AssertMisbehavingMembers(t, result, group.MemberIndex(1))
+	dkgtest.AssertValidGroupPublicKey(t, result)
+	dkgtest.AssertResultSupportingMembers(t, result, []group.MemberIndex{2, 3, 4, 5}...)
+}
+
 func TestExecute_DQ_member5_invalidCommitmentsMessage_phase4(t *testing.T) {
 	t.Parallel()
 
@@ -1233,7 +1275,8 @@ type manInTheMiddle struct {
 	seed *big.Int
 
 	// phase 1
-	ephemeralKeyPairs map[group.MemberIndex]*ephemeral.KeyPair
+	ephemeralKeyPairs  map[group.MemberIndex]*ephemeral.KeyPair
+	ephemeralKeyProofs map[group.MemberIndex]*zkp.DiscreteLogProof
 
 	// phase 2
 	symmetricKeys      map[group.MemberIndex]*ephemeral.SymmetricEcdhKey
@@ -1256,6 +1299,7 @@ func newManInTheMiddle(
 	seed *big.Int,
 ) (*manInTheMiddle, error) {
 	ephemeralKeyPairs := make(map[group.MemberIndex]*ephemeral.KeyPair, groupSize-1)
+	ephemeralKeyProofs := make(map[group.MemberIndex]*zkp.DiscreteLogProof, groupSize-1)
 	sharesS := make(map[group.MemberIndex]*big.Int, groupSize-1)
 	sharesT := make(map[group.MemberIndex]*big.Int, groupSize-1)
 
@@ -1278,6 +1322,17 @@ func newManInTheMiddle(
 		}
 		ephemeralKeyPairs[receiverIndex] = keyPair
 
+		// proof of knowledge of the ephemeral private key; it has to be
+		// replaced together with the public key in phase 1
+		proof, err := zkp.ProveDiscreteLog(
+			keyPair.PrivateKey,
+			gjkr.EphemeralPublicKeyProofContext(seed, senderIndex, receiverIndex),
+		)
+		if err != nil {
+			return nil, err
+		}
+		ephemeralKeyProofs[receiverIndex] = proof
+
 		// shares used in the third phase of the protocol
 		// those shares will be encrypted with the symmetric key and
 		// used as shares of sender generated for the receiver
@@ -1312,7 +1367,8 @@ func newManInTheMiddle(
 		senderIndex: senderIndex,
 		seed:        seed,
 
-		ephemeralKeyPairs: ephemeralKeyPairs,
+		ephemeralKeyPairs:  ephemeralKeyPairs,
+		ephemeralKeyProofs: ephemeralKeyProofs,
 
 		symmetricKeys:      make(map[group.MemberIndex]*ephemeral.SymmetricEcdhKey),
 		symmetricKeysMutex: sync.Mutex{},
@@ -1338,13 +1394,18 @@ func (mitm *manInTheMiddle) interceptCommunication(
 	// Phase 1:
 	// Original sender broadcasts EphemeralPublicKeyMessage.
 	// We intercept that message and replace the public key generated for
-	// each receiver with public key generated earlier by the man in the middle.
+	// each receiver with public key generated earlier by the man in the middle,
+	// along with the proof of knowledge of the matching private key.
 	if ok && publicKeyMessage.SenderID() == mitm.senderIndex {
 		for receiverIndex, ephemeralKeyPair := range mitm.ephemeralKeyPairs {
 			publicKeyMessage.SetPublicKey(
 				receiverIndex,
 				ephemeralKeyPair.PublicKey,
 			)
+			publicKeyMessage.SetPublicKeyProof(
+				receiverIndex,
+				mitm.ephemeralKeyProofs[receiverIndex],
+			)
 		}
 		return publicKeyMessage
 	}
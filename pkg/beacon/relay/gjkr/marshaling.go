@@ -7,20 +7,9 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr/gen/pb"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral/zkp"
 )
 
-// MemberIndex is represented as uint8 in gjkr. Protobuf does not have uint8
-// type so we are using uint32. When unmarshalling message, we need to make
-// sure we do not overflow.
-const maxMemberIndex = 255
-
-func validateMemberIndex(protoIndex uint32) error {
-	if protoIndex > maxMemberIndex {
-		return fmt.Errorf("Invalid member index value: [%v]", protoIndex)
-	}
-	return nil
-}
-
 // Type returns a string describing an EphemeralPublicKeyMessage type for
 // marshaling purposes.
 func (epkm *EphemeralPublicKeyMessage) Type() string {
@@ -30,9 +19,17 @@ func (epkm *EphemeralPublicKeyMessage) Type() string {
 // Marshal converts this EphemeralPublicKeyMessage to a byte array suitable for
 // network communication.
 func (epkm *EphemeralPublicKeyMessage) Marshal() ([]byte, error) {
+	ephemeralPublicKeyProofs, err := marshalProofMap(
+		epkm.ephemeralPublicKeyProofs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return (&pb.EphemeralPublicKey{
-		SenderID:            uint32(epkm.senderID),
-		EphemeralPublicKeys: marshalPublicKeyMap(epkm.ephemeralPublicKeys),
+		SenderID:                 uint32(epkm.senderID),
+		EphemeralPublicKeys:      marshalPublicKeyMap(epkm.ephemeralPublicKeys),
+		EphemeralPublicKeyProofs: ephemeralPublicKeyProofs,
 	}).Marshal()
 }
 
@@ -44,7 +41,7 @@ func (epkm *EphemeralPublicKeyMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	epkm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -56,6 +53,15 @@ func (epkm *EphemeralPublicKeyMessage) Unmarshal(bytes []byte) error {
 
 	epkm.ephemeralPublicKeys = ephemeralPublicKeys
 
+	ephemeralPublicKeyProofs, err := unmarshalProofMap(
+		pbMsg.EphemeralPublicKeyProofs,
+	)
+	if err != nil {
+		return err
+	}
+
+	epkm.ephemeralPublicKeyProofs = ephemeralPublicKeyProofs
+
 	return nil
 }
 
@@ -87,7 +93,7 @@ func (mcm *MemberCommitmentsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	mcm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -139,14 +145,14 @@ func (psm *PeerSharesMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	psm.senderID = group.MemberIndex(pbMsg.SenderID)
 
 	shares := make(map[group.MemberIndex]*peerShares)
 	for memberID, pbShares := range pbMsg.Shares {
-		if err := validateMemberIndex(memberID); err != nil {
+		if err := group.ValidateWireMemberIndex(memberID); err != nil {
 			return err
 		}
 		shares[group.MemberIndex(memberID)] = &peerShares{
@@ -183,7 +189,7 @@ func (ssam *SecretSharesAccusationsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	ssam.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -226,7 +232,7 @@ func (mpspm *MemberPublicKeySharePointsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	mpspm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -271,7 +277,7 @@ func (pam *PointsAccusationsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	pam.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -309,7 +315,7 @@ func (mekm *MisbehavedEphemeralKeysMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := group.ValidateWireMemberIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	mekm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -339,7 +345,7 @@ func unmarshalPublicKeyMap(
 ) (map[group.MemberIndex]*ephemeral.PublicKey, error) {
 	var unmarshalled = make(map[group.MemberIndex]*ephemeral.PublicKey, len(publicKeys))
 	for memberID, publicKeyBytes := range publicKeys {
-		if err := validateMemberIndex(memberID); err != nil {
+		if err := group.ValidateWireMemberIndex(memberID); err != nil {
 			return nil, err
 		}
 
@@ -355,6 +361,41 @@ func unmarshalPublicKeyMap(
 	return unmarshalled, nil
 }
 
+func marshalProofMap(
+	proofs map[group.MemberIndex]*zkp.DiscreteLogProof,
+) (map[uint32][]byte, error) {
+	marshalled := make(map[uint32][]byte, len(proofs))
+	for id, proof := range proofs {
+		proofBytes, err := proof.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal proof [%v]", err)
+		}
+
+		marshalled[uint32(id)] = proofBytes
+	}
+	return marshalled, nil
+}
+
+func unmarshalProofMap(
+	proofs map[uint32][]byte,
+) (map[group.MemberIndex]*zkp.DiscreteLogProof, error) {
+	var unmarshalled = make(map[group.MemberIndex]*zkp.DiscreteLogProof, len(proofs))
+	for memberID, proofBytes := range proofs {
+		if err := group.ValidateWireMemberIndex(memberID); err != nil {
+			return nil, err
+		}
+
+		proof := &zkp.DiscreteLogProof{}
+		if err := proof.Unmarshal(proofBytes); err != nil {
+			return nil, fmt.Errorf("could not unmarshal proof [%v]", err)
+		}
+
+		unmarshalled[group.MemberIndex(memberID)] = proof
+	}
+
+	return unmarshalled, nil
+}
+
 func marshalPrivateKeyMap(
 	privateKeys map[group.MemberIndex]*ephemeral.PrivateKey,
 ) map[uint32][]byte {
@@ -370,7 +411,7 @@ func unmarshalPrivateKeyMap(
 ) (map[group.MemberIndex]*ephemeral.PrivateKey, error) {
 	var unmarshalled = make(map[group.MemberIndex]*ephemeral.PrivateKey, len(privateKeys))
 	for memberID, privateKeyBytes := range privateKeys {
-		if err := validateMemberIndex(memberID); err != nil {
+		if err := group.ValidateWireMemberIndex(memberID); err != nil {
 			return nil, err
 		}
 		unmarshalled[group.MemberIndex(memberID)] = ephemeral.UnmarshalPrivateKey(privateKeyBytes)
@@ -9,6 +9,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/internal/pbutils"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral/zkp"
 )
 
 func TestEphemeralPublicKeyMessageRoundtrip(t *testing.T) {
@@ -26,9 +27,24 @@ func TestEphemeralPublicKeyMessageRoundtrip(t *testing.T) {
 	publicKeys[group.MemberIndex(211)] = keyPair1.PublicKey
 	publicKeys[group.MemberIndex(19)] = keyPair2.PublicKey
 
+	proof1, err := zkp.ProveDiscreteLog(keyPair1.PrivateKey, []byte{211})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proof2, err := zkp.ProveDiscreteLog(keyPair2.PrivateKey, []byte{19})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proofs := make(map[group.MemberIndex]*zkp.DiscreteLogProof)
+	proofs[group.MemberIndex(211)] = proof1
+	proofs[group.MemberIndex(19)] = proof2
+
 	msg := &EphemeralPublicKeyMessage{
-		senderID:            group.MemberIndex(38),
-		ephemeralPublicKeys: publicKeys,
+		senderID:                 group.MemberIndex(38),
+		ephemeralPublicKeys:      publicKeys,
+		ephemeralPublicKeyProofs: proofs,
 	}
 	unmarshaled := &EphemeralPublicKeyMessage{}
 
@@ -223,6 +223,89 @@ type FinalizingMember struct {
 	*CombiningMember
 }
 
+// WipeSecrets overwrites private values used during distributed key
+// generation with zeros, so they do not linger in memory once the protocol
+// completes. Member's share of the group private key is part of the result and
+// is not wiped.
+func (fm *FinalizingMember) WipeSecrets() {
+	fm.wipeSecrets()
+}
+
+// wipeSecrets overwrites ephemeral private keys generated in phase 1 with
+// zeros and drops the key pairs.
+func (em *EphemeralKeyPairGeneratingMember) wipeSecrets() {
+	for _, keyPair := range em.ephemeralKeyPairs {
+		wipe(keyPair.PrivateKey.D)
+	}
+	em.ephemeralKeyPairs = nil
+}
+
+// wipeSecrets wipes secrets of the previous phases and drops symmetric keys.
+// Symmetric keys keep the shared secret inside an `encryption.Box` which does
+// not expose it, so it can not be zeroed here; dropping the references lets
+// the garbage collector reclaim it.
+func (sm *SymmetricKeyGeneratingMember) wipeSecrets() {
+	sm.EphemeralKeyPairGeneratingMember.wipeSecrets()
+	sm.symmetricKeys = nil
+}
+
+// wipeSecrets wipes secrets of the previous phases, polynomial coefficients
+// and shares calculated by the member for themself.
+func (cm *CommittingMember) wipeSecrets() {
+	cm.SymmetricKeyGeneratingMember.wipeSecrets()
+
+	cm.wipeSecretCoefficients()
+	wipe(cm.selfSecretShareS)
+	wipe(cm.selfSecretShareT)
+}
+
+// wipeSecretCoefficients overwrites polynomial coefficients with zeros.
+func (cm *CommittingMember) wipeSecretCoefficients() {
+	for _, coefficient := range cm.secretCoefficients {
+		wipe(coefficient)
+	}
+}
+
+// wipeSecrets wipes secrets of the previous phases and shares received from
+// peer group members.
+func (cvm *CommitmentsVerifyingMember) wipeSecrets() {
+	cvm.CommittingMember.wipeSecrets()
+
+	for _, share := range cvm.receivedQualifiedSharesS {
+		wipe(share)
+	}
+	for _, share := range cvm.receivedQualifiedSharesT {
+		wipe(share)
+	}
+}
+
+// wipeSecrets wipes secrets of the previous phases and individual private
+// keys reconstructed for misbehaving members.
+func (rm *ReconstructingMember) wipeSecrets() {
+	rm.CommitmentsVerifyingMember.wipeSecrets()
+
+	for _, individualPrivateKey := range rm.reconstructedIndividualPrivateKeys {
+		wipe(individualPrivateKey)
+	}
+}
+
+// wipe overwrites the whole backing array of the given value with zeros and
+// sets the value to zero. Arithmetic operations often leave limbs of
+// intermediate results in the spare capacity of the array, so clearing only
+// the used words is not enough.
+func wipe(value *big.Int) {
+	if value == nil {
+		return
+	}
+
+	words := value.Bits()
+	words = words[:cap(words)]
+	for i := range words {
+		words[i] = 0
+	}
+	value.SetInt64(0)
+}
+
 // NewMember creates a new member in an initial state
 func NewMember(
 	memberID group.MemberIndex,
@@ -231,10 +314,18 @@ func NewMember(
 	membershipValidator group.MembershipValidator,
 	seed *big.Int,
 ) (*LocalMember, error) {
+	dkgGroup, err := group.NewDkgGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := dkgGroup.ValidateMemberIndex(memberID); err != nil {
+		return nil, err
+	}
+
 	return &LocalMember{
 		memberCore: &memberCore{
 			memberID,
-			group.NewDkgGroup(dishonestThreshold, groupSize),
+			dkgGroup,
 			membershipValidator,
 			newDkgEvidenceLog(),
 			newProtocolParameters(seed),
@@ -327,7 +418,8 @@ func (rm *ReconstructingMember) InitializeCombining() *CombiningMember {
 }
 
 // individualPrivateKey returns current member's individual private key.
-// Individual private key is zeroth polynomial coefficient `a_i0`.
+// Individual private key is zeroth polynomial coefficient `a_i0`. Coefficients
+// are wiped once the public key share points are published in phase 7.
 func (rm *ReconstructingMember) individualPrivateKey() *big.Int {
 	return rm.secretCoefficients[0]
 }
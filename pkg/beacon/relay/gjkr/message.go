@@ -7,6 +7,7 @@ import (
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral/zkp"
 )
 
 // EphemeralPublicKeyMessage is a message payload that carries the sender's
@@ -22,10 +23,16 @@ import (
 // must know its ephemeral public key prior to exchanging any messages. Hence,
 // this message contains all the generated public keys and it is broadcast
 // within the group.
+//
+// Each public key is accompanied by a proof that the sender knows the
+// corresponding private key. Without it, a member could broadcast a key it
+// copied from another member and later fail to reveal a matching private key
+// when accused.
 type EphemeralPublicKeyMessage struct {
 	senderID group.MemberIndex // i
 
-	ephemeralPublicKeys map[group.MemberIndex]*ephemeral.PublicKey // j -> Y_ij
+	ephemeralPublicKeys      map[group.MemberIndex]*ephemeral.PublicKey  // j -> Y_ij
+	ephemeralPublicKeyProofs map[group.MemberIndex]*zkp.DiscreteLogProof // j -> proof of y_ij
 }
 
 // MemberCommitmentsMessage is a message payload that carries the sender's
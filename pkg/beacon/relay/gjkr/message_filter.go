@@ -98,5 +98,6 @@ func (mc *memberCore) IsSenderValid(
 	senderID group.MemberIndex,
 	senderPublicKey []byte,
 ) bool {
-	return mc.membershipValidator.IsValidMembership(senderID, senderPublicKey)
+	return mc.group.ValidateMemberIndex(senderID) == nil &&
+		mc.membershipValidator.IsValidMembership(senderID, senderPublicKey)
 }
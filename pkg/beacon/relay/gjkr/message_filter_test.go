@@ -7,10 +7,15 @@ import (
 )
 
 func TestFilterSymmetricKeyGeneratingMembers(t *testing.T) {
+	dkgGroup, err := group.NewDkgGroup(8, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	member := (&LocalMember{
 		memberCore: &memberCore{
 			ID:    13,
-			group: group.NewDkgGroup(8, 15),
+			group: dkgGroup,
 		},
 	}).InitializeEphemeralKeysGeneration().
 		InitializeSymmetricKeyGeneration()
@@ -30,10 +35,15 @@ func TestFilterSymmetricKeyGeneratingMembers(t *testing.T) {
 }
 
 func TestFilterCommitmentsVefiryingMembers(t *testing.T) {
+	dkgGroup, err := group.NewDkgGroup(49, 96)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	member := (&LocalMember{
 		memberCore: &memberCore{
 			ID:    93,
-			group: group.NewDkgGroup(49, 96),
+			group: dkgGroup,
 		},
 	}).InitializeEphemeralKeysGeneration().
 		InitializeSymmetricKeyGeneration().
@@ -72,10 +82,15 @@ func TestFilterCommitmentsVefiryingMembers(t *testing.T) {
 }
 
 func TestFilterSharingMembers(t *testing.T) {
+	dkgGroup, err := group.NewDkgGroup(13, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	member := (&LocalMember{
 		memberCore: &memberCore{
 			ID:    24,
-			group: group.NewDkgGroup(13, 24),
+			group: dkgGroup,
 		},
 	}).InitializeEphemeralKeysGeneration().
 		InitializeSymmetricKeyGeneration().
@@ -99,10 +114,15 @@ func TestFilterSharingMembers(t *testing.T) {
 }
 
 func TestFilterReconstructingMember(t *testing.T) {
+	dkgGroup, err := group.NewDkgGroup(23, 44)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	member := (&LocalMember{
 		memberCore: &memberCore{
 			ID:    44,
-			group: group.NewDkgGroup(23, 44),
+			group: dkgGroup,
 		},
 	}).InitializeEphemeralKeysGeneration().
 		InitializeSymmetricKeyGeneration().
@@ -18,11 +18,13 @@ import (
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral/zkp"
 )
 
 // GenerateEphemeralKeyPair takes the group member list and generates an
 // ephemeral ECDH keypair for every other group member. Generated public
-// ephemeral keys are broadcasted within the group.
+// ephemeral keys are broadcasted within the group together with proofs that
+// this member knows the corresponding private keys.
 //
 // See Phase 1 of the protocol specification.
 func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
@@ -30,6 +32,7 @@ func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
 	error,
 ) {
 	ephemeralKeys := make(map[group.MemberIndex]*ephemeral.PublicKey)
+	ephemeralKeyProofs := make(map[group.MemberIndex]*zkp.DiscreteLogProof)
 
 	// Calculate ephemeral key pair for every other group member
 	for _, member := range em.group.MemberIDs() {
@@ -48,11 +51,22 @@ func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
 
 		// store the public key to the map for the message
 		ephemeralKeys[member] = ephemeralKeyPair.PublicKey
+
+		// prove we know the private key behind the public key
+		proof, err := zkp.ProveDiscreteLog(
+			ephemeralKeyPair.PrivateKey,
+			em.protocolParameters.ephemeralPublicKeyProofContext(em.ID, member),
+		)
+		if err != nil {
+			return nil, err
+		}
+		ephemeralKeyProofs[member] = proof
 	}
 
 	return &EphemeralPublicKeyMessage{
-		senderID:            em.ID,
-		ephemeralPublicKeys: ephemeralKeys,
+		senderID:                 em.ID,
+		ephemeralPublicKeys:      ephemeralKeys,
+		ephemeralPublicKeyProofs: ephemeralKeyProofs,
 	}, nil
 }
 
@@ -113,7 +127,9 @@ func (sm *SymmetricKeyGeneratingMember) GenerateSymmetricKeys(
 
 // isValidEphemeralPublicKeyMessage validates a given EphemeralPublicKeyMessage.
 // Message is considered valid if it contains ephemeral public keys for
-// all other group members.
+// all other group members and a valid proof of knowledge of the private key
+// for each of them. All proofs are verified, not only the one for this member,
+// so that every honest member disqualifies the sender in the same way.
 func (sm *SymmetricKeyGeneratingMember) isValidEphemeralPublicKeyMessage(
 	message *EphemeralPublicKeyMessage,
 ) bool {
@@ -123,7 +139,8 @@ func (sm *SymmetricKeyGeneratingMember) isValidEphemeralPublicKeyMessage(
 			continue
 		}
 
-		if _, ok := message.ephemeralPublicKeys[memberID]; !ok {
+		publicKey, ok := message.ephemeralPublicKeys[memberID]
+		if !ok {
 			logger.Warningf(
 				"[member:%v] ephemeral public key message from member [%v] "+
 					"does not contain public key for member [%v]",
@@ -133,6 +150,33 @@ func (sm *SymmetricKeyGeneratingMember) isValidEphemeralPublicKeyMessage(
 			)
 			return false
 		}
+
+		proof, ok := message.ephemeralPublicKeyProofs[memberID]
+		if !ok || proof == nil {
+			logger.Warningf(
+				"[member:%v] ephemeral public key message from member [%v] "+
+					"does not contain public key proof for member [%v]",
+				sm.ID,
+				message.senderID,
+				memberID,
+			)
+			return false
+		}
+
+		proofContext := sm.protocolParameters.ephemeralPublicKeyProofContext(
+			message.senderID,
+			memberID,
+		)
+		if !proof.Verify(publicKey, proofContext) {
+			logger.Warningf(
+				"[member:%v] ephemeral public key message from member [%v] "+
+					"contains invalid public key proof for member [%v]",
+				sm.ID,
+				message.senderID,
+				memberID,
+			)
+			return false
+		}
 	}
 
 	return true
@@ -783,7 +827,7 @@ func findPublicKey(
 //
 // See Phase 6 of the protocol specification.
 func (qm *QualifiedMember) CombineMemberShares() {
-	combinedSharesS := qm.selfSecretShareS // s_ii
+	combinedSharesS := new(big.Int).Set(qm.selfSecretShareS) // s_ii
 	for _, s := range qm.receivedQualifiedSharesS {
 		combinedSharesS = new(big.Int).Mod(
 			new(big.Int).Add(combinedSharesS, s),
@@ -1,12 +1,15 @@
 package gjkr
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
 )
 
 func TestCombineGroupPublicKey(t *testing.T) {
@@ -204,6 +207,197 @@ func TestCombineGroupPublicKeyShares_WithReconstruction(t *testing.T) {
 	}
 }
 
+func TestWipeSecrets(t *testing.T) {
+	finalizingMember, expectedGroupPrivateKeyShare := initializeWipingMember(t)
+
+	secrets := secretWords(finalizingMember)
+
+	finalizingMember.WipeSecrets()
+
+	assertSecretsWiped(t, finalizingMember, secrets)
+	assertGroupPrivateKeyShare(t, finalizingMember, expectedGroupPrivateKeyShare)
+}
+
+func TestFinalizationStateWipesSecrets(t *testing.T) {
+	finalizingMember, expectedGroupPrivateKeyShare := initializeWipingMember(t)
+
+	secrets := secretWords(finalizingMember)
+
+	state := &finalizationState{member: finalizingMember}
+	if err := state.Initiate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	assertSecretsWiped(t, finalizingMember, secrets)
+	assertGroupPrivateKeyShare(t, state.member, expectedGroupPrivateKeyShare)
+}
+
+func TestAbortedExecutionWipesSecrets(t *testing.T) {
+	finalizingMember, _ := initializeWipingMember(t)
+
+	secrets := secretWords(finalizingMember)
+
+	// execution stopped in phase 12, before reaching finalization
+	wipeSecrets(&combinationState{member: finalizingMember.CombiningMember})
+
+	assertSecretsWiped(t, finalizingMember, secrets)
+}
+
+func TestPointsShareStateWipesSecretCoefficients(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 3
+
+	members, err := initializeSharingMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	member := members[0]
+
+	coefficients := make(map[string][]big.Word)
+	for i, coefficient := range member.secretCoefficients {
+		words := coefficient.Bits()
+		coefficients[fmt.Sprintf("secret coefficient %v", i)] = words[:cap(words)]
+	}
+
+	channel, err := netLocal.Connect().BroadcastChannelFor("points_share_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	RegisterUnmarshallers(channel)
+
+	state := &pointsShareState{channel: channel, member: member}
+	if err := state.Initiate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(member.publicKeySharePoints) != dishonestThreshold+1 {
+		t.Fatalf(
+			"unexpected number of public key share points\nexpected: %v\nactual:   %v",
+			dishonestThreshold+1,
+			len(member.publicKeySharePoints),
+		)
+	}
+
+	for name, words := range coefficients {
+		for _, word := range words {
+			if word != 0 {
+				t.Errorf("%v has non-zero words left: [%v]", name, words)
+				break
+			}
+		}
+	}
+}
+
+func initializeWipingMember(t *testing.T) (*FinalizingMember, *big.Int) {
+	dishonestThreshold := 1
+	groupSize := 3
+
+	members, err := initializeCombiningMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	member := members[0]
+
+	member.selfSecretShareS = big.NewInt(101)
+	member.selfSecretShareT = big.NewInt(102)
+	member.reconstructedIndividualPrivateKeys[4] = big.NewInt(103)
+	member.CombineMemberShares()
+
+	// Shift a 512-bit value in place, so the share keeps the limbs of
+	// the intermediate value in the spare capacity of its backing array.
+	shareWithLeftovers := new(big.Int).SetBytes(bytes.Repeat([]byte{0xff}, 64))
+	shareWithLeftovers.Rsh(shareWithLeftovers, 448)
+	member.receivedQualifiedSharesS[2] = shareWithLeftovers
+
+	expectedGroupPrivateKeyShare := new(big.Int).Set(member.groupPrivateKeyShare)
+
+	finalizingMember := member.InitializeFinalization()
+	if len(finalizingMember.ephemeralKeyPairs) == 0 {
+		t.Fatal("member has no ephemeral key pairs")
+	}
+	if len(finalizingMember.symmetricKeys) == 0 {
+		t.Fatal("member has no symmetric keys")
+	}
+
+	return finalizingMember, expectedGroupPrivateKeyShare
+}
+
+// secretWords captures the full backing arrays of all secret values of the
+// member, so they can be inspected after the values are reset.
+func secretWords(member *FinalizingMember) map[string][]big.Word {
+	secrets := make(map[string][]big.Word)
+	capture := func(name string, value *big.Int) {
+		words := value.Bits()
+		secrets[name] = words[:cap(words)]
+	}
+
+	for memberID, keyPair := range member.ephemeralKeyPairs {
+		capture(
+			fmt.Sprintf("ephemeral private key for member %v", memberID),
+			keyPair.PrivateKey.D,
+		)
+	}
+	for i, coefficient := range member.secretCoefficients {
+		capture(fmt.Sprintf("secret coefficient %v", i), coefficient)
+	}
+	capture("self secret share S", member.selfSecretShareS)
+	capture("self secret share T", member.selfSecretShareT)
+	for memberID, share := range member.receivedQualifiedSharesS {
+		capture(fmt.Sprintf("share S from member %v", memberID), share)
+	}
+	for memberID, share := range member.receivedQualifiedSharesT {
+		capture(fmt.Sprintf("share T from member %v", memberID), share)
+	}
+	for memberID, key := range member.reconstructedIndividualPrivateKeys {
+		capture(
+			fmt.Sprintf("reconstructed private key of member %v", memberID),
+			key,
+		)
+	}
+
+	return secrets
+}
+
+func assertSecretsWiped(
+	t *testing.T,
+	member *FinalizingMember,
+	secrets map[string][]big.Word,
+) {
+	for name, words := range secrets {
+		if len(words) == 0 {
+			t.Errorf("%v has no words to inspect", name)
+		}
+		for _, word := range words {
+			if word != 0 {
+				t.Errorf("%v has non-zero words left: [%v]", name, words)
+				break
+			}
+		}
+	}
+
+	if member.ephemeralKeyPairs != nil {
+		t.Errorf("ephemeral key pairs have not been dropped")
+	}
+	if member.symmetricKeys != nil {
+		t.Errorf("symmetric keys have not been dropped")
+	}
+}
+
+func assertGroupPrivateKeyShare(
+	t *testing.T,
+	member *FinalizingMember,
+	expectedGroupPrivateKeyShare *big.Int,
+) {
+	groupPrivateKeyShare := member.Result().GroupPrivateKeyShare
+	if groupPrivateKeyShare.Cmp(expectedGroupPrivateKeyShare) != 0 {
+		t.Fatalf(
+			"unexpected group private key share\nexpected: %v\nactual:   %v\n",
+			expectedGroupPrivateKeyShare,
+			groupPrivateKeyShare,
+		)
+	}
+}
+
 func initializeCombiningMembersGroup(
 	dishonestThreshold,
 	groupSize int,
@@ -15,10 +15,13 @@ func TestSaveEphemeralKeyMessagesForEvidence(t *testing.T) {
 	dishonestThreshold := 0
 
 	// Create a group of 2 members
-	ephemeralGeneratingMembers := initializeEphemeralKeyPairMembersGroup(
+	ephemeralGeneratingMembers, err := initializeEphemeralKeyPairMembersGroup(
 		dishonestThreshold,
 		groupSize,
 	)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	member1 := ephemeralGeneratingMembers[0]
 	member2 := ephemeralGeneratingMembers[1]
@@ -57,10 +60,13 @@ func TestGenerateEphemeralKeys(t *testing.T) {
 	dishonestThreshold := 0
 
 	// Create a group of 3 members
-	ephemeralGeneratingMembers := initializeEphemeralKeyPairMembersGroup(
+	ephemeralGeneratingMembers, err := initializeEphemeralKeyPairMembersGroup(
 		dishonestThreshold,
 		groupSize,
 	)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	// generate ephemeral key pairs for each group member; prepare messages
 	broadcastedPubKeyMessages := make(map[group.MemberIndex]*EphemeralPublicKeyMessage)
@@ -138,11 +144,105 @@ func TestGenerateEphemeralKeys(t *testing.T) {
 	}
 }
 
+func TestDisqualifyMemberWithInvalidEphemeralPublicKeyProof(t *testing.T) {
+	groupSize := 3
+	dishonestThreshold := 0
+
+	var tests = map[string]struct {
+		modifyMessage func(
+			message *EphemeralPublicKeyMessage,
+			otherMessage *EphemeralPublicKeyMessage,
+		)
+		expectedDisqualified []group.MemberIndex
+	}{
+		"valid proofs": {
+			modifyMessage: func(
+				message *EphemeralPublicKeyMessage,
+				otherMessage *EphemeralPublicKeyMessage,
+			) {
+			},
+			expectedDisqualified: []group.MemberIndex{},
+		},
+		"missing proof for other member": {
+			modifyMessage: func(
+				message *EphemeralPublicKeyMessage,
+				otherMessage *EphemeralPublicKeyMessage,
+			) {
+				delete(message.ephemeralPublicKeyProofs, group.MemberIndex(3))
+			},
+			expectedDisqualified: []group.MemberIndex{1},
+		},
+		"proof for another receiver": {
+			modifyMessage: func(
+				message *EphemeralPublicKeyMessage,
+				otherMessage *EphemeralPublicKeyMessage,
+			) {
+				message.ephemeralPublicKeys[3] = message.ephemeralPublicKeys[2]
+				message.ephemeralPublicKeyProofs[3] = message.ephemeralPublicKeyProofs[2]
+			},
+			expectedDisqualified: []group.MemberIndex{1},
+		},
+		"public key and proof copied from another member": {
+			modifyMessage: func(
+				message *EphemeralPublicKeyMessage,
+				otherMessage *EphemeralPublicKeyMessage,
+			) {
+				message.ephemeralPublicKeys[3] = otherMessage.ephemeralPublicKeys[3]
+				message.ephemeralPublicKeyProofs[3] = otherMessage.ephemeralPublicKeyProofs[3]
+			},
+			expectedDisqualified: []group.MemberIndex{1},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeEphemeralKeyPairMembersGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var messages []*EphemeralPublicKeyMessage
+			for _, member := range members {
+				message, err := member.GenerateEphemeralKeyPair()
+				if err != nil {
+					t.Fatal(err)
+				}
+				messages = append(messages, message)
+			}
+
+			// member 1 misbehaves, member 2 is the one being copied
+			test.modifyMessage(messages[0], messages[1])
+
+			receivingMember := members[1].InitializeSymmetricKeyGeneration()
+			if err := receivingMember.GenerateSymmetricKeys(
+				[]*EphemeralPublicKeyMessage{messages[0], messages[2]},
+			); err != nil {
+				t.Fatal(err)
+			}
+
+			disqualified := receivingMember.group.DisqualifiedMemberIDs()
+			if !reflect.DeepEqual(test.expectedDisqualified, disqualified) {
+				t.Fatalf(
+					"unexpected disqualified members\nexpected: %v\nactual:   %v",
+					test.expectedDisqualified,
+					disqualified,
+				)
+			}
+		})
+	}
+}
+
 func initializeEphemeralKeyPairMembersGroup(
 	dishonestThreshold int,
 	groupSize int,
-) []*EphemeralKeyPairGeneratingMember {
-	dkgGroup := group.NewDkgGroup(dishonestThreshold, groupSize)
+) ([]*EphemeralKeyPairGeneratingMember, error) {
+	dkgGroup, err := group.NewDkgGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		return nil, err
+	}
 
 	protocolParameters := newProtocolParameters(big.NewInt(18313131145))
 
@@ -162,14 +262,20 @@ func initializeEphemeralKeyPairMembersGroup(
 		})
 	}
 
-	return members
+	return members, nil
 }
 
 func initializeSymmetricKeyMembersGroup(
 	dishonestThreshold int,
 	groupSize int,
 ) ([]*SymmetricKeyGeneratingMember, error) {
-	keyPairMembers := initializeEphemeralKeyPairMembersGroup(dishonestThreshold, groupSize)
+	keyPairMembers, err := initializeEphemeralKeyPairMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	// generate ephemeral key pairs for all other members of the group
 	for _, member1 := range keyPairMembers {
@@ -228,7 +334,10 @@ func generateGroupWithEphemeralKeys(
 		// simulating message broadcast in the group
 		for _, member := range symmetricKeyMembers {
 			member.evidenceLog.PutEphemeralMessage(
-				&EphemeralPublicKeyMessage{member1.ID, ephemeralKeys},
+				&EphemeralPublicKeyMessage{
+					senderID:            member1.ID,
+					ephemeralPublicKeys: ephemeralKeys,
+				},
 			)
 		}
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/altbn128"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 )
 
 // protocolParameters holds all cryptographic parameters that must be the same
@@ -24,3 +25,14 @@ func newProtocolParameters(seed *big.Int) *protocolParameters {
 		H: altbn128.G1HashToPoint(seed.Bytes()),
 	}
 }
+
+// ephemeralPublicKeyProofContext returns the context to which the proof of
+// knowledge of the ephemeral private key generated by the sender for the
+// receiver is bound. `H` is derived from the seed, so the context is unique
+// for the protocol execution. Binding the sender and receiver indexes makes
+// it impossible to reuse another member's key and proof as one's own.
+func (pp *protocolParameters) ephemeralPublicKeyProofContext(
+	senderID, receiverID group.MemberIndex,
+) []byte {
+	return append(pp.H.Marshal(), byte(senderID), byte(receiverID))
+}
@@ -93,6 +93,10 @@ func (ekpgs *ephemeralKeyPairGenerationState) MemberIndex() group.MemberIndex {
 	return ekpgs.member.ID
 }
 
+func (ekpgs *ephemeralKeyPairGenerationState) wipeSecrets() {
+	ekpgs.member.wipeSecrets()
+}
+
 // symmetricKeyGenerationState is the state during which members compute
 // symmetric keys from the previously exchanged ephemeral public keys.
 // No messages are valid in this state.
@@ -133,6 +137,10 @@ func (skgs *symmetricKeyGenerationState) MemberIndex() group.MemberIndex {
 	return skgs.member.ID
 }
 
+func (skgs *symmetricKeyGenerationState) wipeSecrets() {
+	skgs.member.wipeSecrets()
+}
+
 // commitmentState is the state during which members compute their individual
 // shares and commitments to those shares. Two messages are valid in this state:
 // - `PeerSharesMessage`
@@ -209,6 +217,10 @@ func (cs *commitmentState) MemberIndex() group.MemberIndex {
 	return cs.member.ID
 }
 
+func (cs *commitmentState) wipeSecrets() {
+	cs.member.wipeSecrets()
+}
+
 // commitmentsVerificationState is the state during which members validate
 // shares and commitments computed and published by other members in the
 // previous phase. `SecretShareAccusationMessage`s are valid in this state.
@@ -281,6 +293,10 @@ func (cvs *commitmentsVerificationState) MemberIndex() group.MemberIndex {
 	return cvs.member.ID
 }
 
+func (cvs *commitmentsVerificationState) wipeSecrets() {
+	cvs.member.wipeSecrets()
+}
+
 // sharesJustificationState is the state during which members resolve
 // accusations published by other group members in the previous state.
 // No messages are valid in this state.
@@ -329,6 +345,10 @@ func (sjs *sharesJustificationState) MemberIndex() group.MemberIndex {
 	return sjs.member.ID
 }
 
+func (sjs *sharesJustificationState) wipeSecrets() {
+	sjs.member.wipeSecrets()
+}
+
 // qualificationState is the state during which group members combine all valid
 // secret shares published by other group members in the previous states.
 // No messages are valid in this state.
@@ -367,6 +387,10 @@ func (qs *qualificationState) MemberIndex() group.MemberIndex {
 	return qs.member.ID
 }
 
+func (qs *qualificationState) wipeSecrets() {
+	qs.member.wipeSecrets()
+}
+
 // pointsShareState is the state during which group members calculate and
 // publish their public key share points.
 // `MemberPublicKeySharePointsMessage`s are valid in this state.
@@ -393,6 +417,10 @@ func (pss *pointsShareState) Initiate(ctx context.Context) error {
 		return err
 	}
 
+	// Coefficients are no longer needed once their public values
+	// are published.
+	pss.member.wipeSecretCoefficients()
+
 	return nil
 }
 
@@ -422,6 +450,10 @@ func (pss *pointsShareState) MemberIndex() group.MemberIndex {
 	return pss.member.ID
 }
 
+func (pss *pointsShareState) wipeSecrets() {
+	pss.member.wipeSecrets()
+}
+
 // pointsValidationState is the state during which group members validate
 // public key share points published by other group members in the previous
 // state. `PointsAccusationsMessage`s are valid in this state.
@@ -486,6 +518,10 @@ func (pvs *pointsValidationState) MemberIndex() group.MemberIndex {
 	return pvs.member.ID
 }
 
+func (pvs *pointsValidationState) wipeSecrets() {
+	pvs.member.wipeSecrets()
+}
+
 // pointsJustificationState is the state during which group members resolve
 // accusations published by other group members in the previous state.
 // No messages are valid in this state.
@@ -534,6 +570,10 @@ func (pjs *pointsJustificationState) MemberIndex() group.MemberIndex {
 	return pjs.member.ID
 }
 
+func (pjs *pointsJustificationState) wipeSecrets() {
+	pjs.member.wipeSecrets()
+}
+
 // keyRevealState is the state during which group members reveal ephemeral
 // private keys used to create an ephemeral symmetric keys with disqualified
 // members who share a group private key.
@@ -592,6 +632,10 @@ func (rs *keyRevealState) MemberIndex() group.MemberIndex {
 	return rs.member.ID
 }
 
+func (rs *keyRevealState) wipeSecrets() {
+	rs.member.wipeSecrets()
+}
+
 // reconstructionState is the state during which group members reconstruct
 // individual keys of members disqualified in previous states. No messages are
 // valid in this state.
@@ -638,6 +682,10 @@ func (rs *reconstructionState) MemberIndex() group.MemberIndex {
 	return rs.member.ID
 }
 
+func (rs *reconstructionState) wipeSecrets() {
+	rs.member.wipeSecrets()
+}
+
 // combinationState is the state during which group members combine together all
 // qualified key shares to form a group public key. No messages are valid in
 // this state.
@@ -677,6 +725,10 @@ func (cs *combinationState) MemberIndex() group.MemberIndex {
 	return cs.member.ID
 }
 
+func (cs *combinationState) wipeSecrets() {
+	cs.member.wipeSecrets()
+}
+
 // finalizationState is the last state of GJKR DKG protocol - in this state,
 // distributed key generation is completed. No messages are valid in this state.
 //
@@ -696,6 +748,7 @@ func (fs *finalizationState) ActiveBlocks() uint64 {
 }
 
 func (fs *finalizationState) Initiate(ctx context.Context) error {
+	fs.member.WipeSecrets()
 	return nil
 }
 
@@ -711,6 +764,10 @@ func (fs *finalizationState) MemberIndex() group.MemberIndex {
 	return fs.member.ID
 }
 
+func (fs *finalizationState) wipeSecrets() {
+	fs.member.wipeSecrets()
+}
+
 func (fs *finalizationState) result() *Result {
 	return fs.member.Result()
 }
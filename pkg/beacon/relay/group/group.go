@@ -1,5 +1,7 @@
 package group
 
+import "fmt"
+
 // Group is protocol's members group.
 type Group struct {
 	// The maximum number of misbehaving participants for which it is still
@@ -14,11 +16,16 @@ type Group struct {
 }
 
 // NewDkgGroup creates a new Group with the provided dishonest threshold, member
-// identifiers, and empty IA and DQ members list.
-func NewDkgGroup(dishonestThreshold int, size int) *Group {
+// identifiers, and empty IA and DQ members list. It fails if the group is too
+// large to assign a unique member index to every member.
+func NewDkgGroup(dishonestThreshold int, size int) (*Group, error) {
 	memberIDs := make([]MemberIndex, size)
 	for i := 0; i < size; i++ {
-		memberIDs[i] = MemberIndex(i + 1)
+		memberID, err := MemberIndexFromPosition(i)
+		if err != nil {
+			return nil, fmt.Errorf("could not create group [%v]", err)
+		}
+		memberIDs[i] = memberID
 	}
 
 	return &Group{
@@ -26,7 +33,21 @@ func NewDkgGroup(dishonestThreshold int, size int) *Group {
 		disqualifiedMemberIDs: []MemberIndex{},
 		inactiveMemberIDs:     []MemberIndex{},
 		memberIDs:             memberIDs,
+	}, nil
+}
+
+// ValidateMemberIndex checks whether the given member index is within the
+// bounds of this group. Member indexes start with 1 and can not exceed the
+// group size.
+func (g *Group) ValidateMemberIndex(memberIndex MemberIndex) error {
+	if memberIndex == 0 || int(memberIndex) > g.GroupSize() {
+		return fmt.Errorf(
+			"invalid member index value: [%v]; group size is [%v]",
+			memberIndex,
+			g.GroupSize(),
+		)
 	}
+	return nil
 }
 
 // MemberIDs returns IDs of all group members, as initially selected to the
@@ -1,5 +1,49 @@
 package group
 
-// MemberIndex is an index of a member in a group. The maximum member index
-// value is 255.
-type MemberIndex = uint8
+import (
+	"fmt"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+)
+
+// MemberIndex is an index of a member in a group. Member indexes start with 1
+// and the maximum member index value is 255. MemberIndex is the same type the
+// chain uses for group members, so indexes are passed to the chain without
+// conversions.
+type MemberIndex = relaychain.GroupMemberIndex
+
+// MaxMemberIndex is the highest index a group member can have.
+const MaxMemberIndex = 255
+
+// ValidateWireMemberIndex checks whether the given value received from the
+// network is in the range of member indexes. Protobuf does not have uint8
+// type so member indexes are transported as uint32. When unmarshalling
+// a message, we need to make sure the value is not zero and does not overflow
+// MemberIndex. Bounds of a particular group are checked by
+// Group.ValidateMemberIndex.
+func ValidateWireMemberIndex(index uint32) error {
+	if index == 0 || index > MaxMemberIndex {
+		return fmt.Errorf("invalid member index value: [%v]", index)
+	}
+	return nil
+}
+
+// MemberIndexFromPosition converts 0-based position of the member in the group
+// selected on-chain to 1-based member index used by the off-chain protocols.
+// It fails for positions which can not be represented as a member index.
+func MemberIndexFromPosition(position int) (MemberIndex, error) {
+	if position < 0 || position >= MaxMemberIndex {
+		return 0, fmt.Errorf("invalid member position: [%v]", position)
+	}
+	return MemberIndex(position + 1), nil
+}
+
+// MemberPosition converts 1-based member index to 0-based position of the
+// member in the group selected on-chain. It fails for zero index which has no
+// position in the group.
+func MemberPosition(memberIndex MemberIndex) (int, error) {
+	if memberIndex == 0 {
+		return 0, fmt.Errorf("invalid member index value: [%v]", memberIndex)
+	}
+	return int(memberIndex) - 1, nil
+}
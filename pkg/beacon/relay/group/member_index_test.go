@@ -0,0 +1,175 @@
+package group
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestValidateWireMemberIndex(t *testing.T) {
+	var tests = map[string]struct {
+		index         uint32
+		expectedError error
+	}{
+		"first member index": {
+			index:         1,
+			expectedError: nil,
+		},
+		"max member index": {
+			index:         255,
+			expectedError: nil,
+		},
+		"zero member index": {
+			index:         0,
+			expectedError: fmt.Errorf("invalid member index value: [0]"),
+		},
+		"member index overflowing uint8": {
+			index:         256,
+			expectedError: fmt.Errorf("invalid member index value: [256]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := ValidateWireMemberIndex(test.index)
+			if !reflect.DeepEqual(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestMemberIndexPositionConversion(t *testing.T) {
+	for position := 0; position < MaxMemberIndex; position++ {
+		memberIndex, err := MemberIndexFromPosition(position)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if memberIndex != MemberIndex(position+1) {
+			t.Fatalf(
+				"unexpected member index\nexpected: %v\nactual:   %v",
+				position+1,
+				memberIndex,
+			)
+		}
+
+		memberPosition, err := MemberPosition(memberIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if memberPosition != position {
+			t.Fatalf(
+				"unexpected member position\nexpected: %v\nactual:   %v",
+				position,
+				memberPosition,
+			)
+		}
+	}
+}
+
+func TestMemberIndexFromInvalidPosition(t *testing.T) {
+	var tests = map[string]struct {
+		position      int
+		expectedError error
+	}{
+		"negative position": {
+			position:      -1,
+			expectedError: fmt.Errorf("invalid member position: [-1]"),
+		},
+		"position overflowing member index": {
+			position:      MaxMemberIndex,
+			expectedError: fmt.Errorf("invalid member position: [255]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := MemberIndexFromPosition(test.position)
+			if !reflect.DeepEqual(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestMemberPositionOfZeroIndex(t *testing.T) {
+	expectedError := fmt.Errorf("invalid member index value: [0]")
+
+	_, err := MemberPosition(0)
+	if !reflect.DeepEqual(err, expectedError) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			expectedError,
+			err,
+		)
+	}
+}
+
+func TestGroupValidateMemberIndex(t *testing.T) {
+	group, err := NewDkgGroup(2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		memberIndex   MemberIndex
+		expectedError error
+	}{
+		"first member": {
+			memberIndex:   1,
+			expectedError: nil,
+		},
+		"last member": {
+			memberIndex:   5,
+			expectedError: nil,
+		},
+		"zero member index": {
+			memberIndex: 0,
+			expectedError: fmt.Errorf(
+				"invalid member index value: [0]; group size is [5]",
+			),
+		},
+		"member index exceeding group size": {
+			memberIndex: 6,
+			expectedError: fmt.Errorf(
+				"invalid member index value: [6]; group size is [5]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := group.ValidateMemberIndex(test.memberIndex)
+			if !reflect.DeepEqual(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestNewDkgGroupTooLarge(t *testing.T) {
+	expectedError := fmt.Errorf(
+		"could not create group [invalid member position: [255]]",
+	)
+
+	_, err := NewDkgGroup(128, MaxMemberIndex+1)
+	if !reflect.DeepEqual(err, expectedError) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			expectedError,
+			err,
+		)
+	}
+}
@@ -80,7 +80,10 @@ func (smv *StakersMembershipValidator) IsValidMembership(
 		return false
 	}
 
-	index := int(memberID - 1)
+	index, err := MemberPosition(memberID)
+	if err != nil {
+		return false
+	}
 
 	for _, position := range positions {
 		if index == position {
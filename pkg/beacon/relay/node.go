@@ -63,15 +63,20 @@ func (n *Node) JoinGroupIfEligible(
 		return
 	}
 
-	indexes := make([]uint8, 0)
-	for index, selectedStaker := range groupSelectionResult.SelectedStakers {
+	memberIndexes := make([]group.MemberIndex, 0)
+	for position, selectedStaker := range groupSelectionResult.SelectedStakers {
 		// See if we are amongst those chosen
 		if bytes.Compare(selectedStaker, n.Staker.Address()) == 0 {
-			indexes = append(indexes, uint8(index))
+			memberIndex, err := group.MemberIndexFromPosition(position)
+			if err != nil {
+				logger.Errorf("could not determine member index: [%v]", err)
+				return
+			}
+			memberIndexes = append(memberIndexes, memberIndex)
 		}
 	}
 
-	if len(indexes) > 0 {
+	if len(memberIndexes) > 0 {
 		// create temporary broadcast channel for DKG using the group selection
 		// seed
 		broadcastChannel, err := n.netProvider.BroadcastChannelFor(newEntry.Text(16))
@@ -94,9 +99,9 @@ func (n *Node) JoinGroupIfEligible(
 			)
 		}
 
-		for _, index := range indexes {
+		for _, memberIndex := range memberIndexes {
 			// capture player index for goroutine
-			playerIndex := index
+			playerIndex := memberIndex
 
 			go func() {
 				signer, err := dkg.ExecuteDKG(
@@ -36,7 +36,9 @@ func NewMachine(
 }
 
 // Execute state machine starting with initial state up to finalization. It
-// requires the broadcast channel to be pre-initialized.
+// requires the broadcast channel to be pre-initialized. If the execution fails,
+// the state in which it stopped is returned along with the error so the caller
+// can release resources held by that state.
 func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 	recvChan := make(chan net.Message, receiveBuffer)
 	handler := func(msg net.Message) {
@@ -55,7 +57,7 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 	)
 	err := m.blockCounter.WaitForBlockHeight(startBlockHeight)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to wait for the execution start block")
+		return currentState, 0, fmt.Errorf("failed to wait for the execution start block")
 	}
 
 	lastStateEndBlockHeight := startBlockHeight
@@ -69,7 +71,7 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 	)
 	if err != nil {
 		cancelCtx()
-		return nil, 0, err
+		return currentState, 0, err
 	}
 
 	for {
@@ -113,7 +115,7 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 			)
 			if err != nil {
 				cancelCtx()
-				return nil, 0, err
+				return currentState, 0, err
 			}
 
 			continue
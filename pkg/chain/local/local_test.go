@@ -682,7 +682,7 @@ func TestLocalSubmitDKGResultWithSignatures(t *testing.T) {
 
 			errorChan := make(chan error)
 
-			memberIndex := uint8(1)
+			memberIndex := relaychain.GroupMemberIndex(1)
 			result := &relaychain.DKGResult{
 				GroupPublicKey: []byte{11},
 			}
@@ -147,12 +147,20 @@ func executeDKG(
 		chain.Signing(),
 	)
 
-	for i := 0; i < relayConfig.GroupSize; i++ {
-		i := i // capture for goroutine
+	memberIndexes := make([]group.MemberIndex, relayConfig.GroupSize)
+	for position := range memberIndexes {
+		memberIndexes[position], err = group.MemberIndexFromPosition(position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, memberIndex := range memberIndexes {
+		memberIndex := memberIndex // capture for goroutine
 		go func() {
 			signer, err := dkg.ExecuteDKG(
 				seed,
-				uint8(i),
+				memberIndex,
 				relayConfig.GroupSize,
 				relayConfig.DishonestThreshold(),
 				membershipValidator,
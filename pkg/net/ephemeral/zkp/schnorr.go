@@ -0,0 +1,190 @@
+// Package zkp contains non-interactive zero-knowledge proofs used by group
+// members to prove facts about their ephemeral keys without revealing them.
+package zkp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+)
+
+// scalarLength is the length in bytes of a serialized secp256k1 scalar.
+const scalarLength = 32
+
+// DiscreteLogProof is a non-interactive Schnorr proof of knowledge of the
+// ephemeral private key `x` behind the ephemeral public key `X = G * x`.
+// The interactive protocol is turned into a non-interactive one with the
+// Fiat-Shamir heuristic.
+type DiscreteLogProof struct {
+	challenge *big.Int // e
+	response  *big.Int // s
+}
+
+// ProveDiscreteLog generates a proof that the prover knows the given
+// ephemeral private key. The proof is bound to the provided context, e.g.
+// the session and member index, so it can not be replayed by another member
+// or in another protocol execution.
+//
+// The prover picks a random `k`, computes `R = G * k`, the challenge
+// `e = H(context || X || R) mod n`, and the response `s = k - e * x mod n`.
+func ProveDiscreteLog(
+	privateKey *ephemeral.PrivateKey,
+	proofContext []byte,
+) (*DiscreteLogProof, error) {
+	curve := btcec.S256()
+
+	k, err := randomScalar()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate random scalar [%v]", err)
+	}
+
+	rx, ry := curve.ScalarBaseMult(k.Bytes()) // R = G * k
+
+	publicKey := (*btcec.PrivateKey)(privateKey).PubKey()
+	e := computeChallenge(proofContext, publicKey.X, publicKey.Y, rx, ry)
+
+	// s = k - e * x mod n
+	s := new(big.Int).Sub(k, new(big.Int).Mul(e, privateKey.D))
+	s.Mod(s, curve.N)
+
+	return &DiscreteLogProof{
+		challenge: e,
+		response:  s,
+	}, nil
+}
+
+// Verify checks the proof against the given ephemeral public key and context.
+//
+// The verifier reconstructs `R' = G * s + X * e` and accepts the proof if
+// `e == H(context || X || R') mod n`.
+func (p *DiscreteLogProof) Verify(
+	publicKey *ephemeral.PublicKey,
+	proofContext []byte,
+) bool {
+	curve := btcec.S256()
+
+	if !isValidScalar(p.challenge) || !isValidScalar(p.response) {
+		return false
+	}
+	if publicKey == nil || publicKey.X == nil || publicKey.Y == nil {
+		return false
+	}
+	if !curve.IsOnCurve(publicKey.X, publicKey.Y) {
+		return false
+	}
+
+	gsx, gsy := curve.ScalarBaseMult(p.response.Bytes()) // G * s
+	xex, xey := curve.ScalarMult(                        // X * e
+		publicKey.X,
+		publicKey.Y,
+		p.challenge.Bytes(),
+	)
+	rx, ry := curve.Add(gsx, gsy, xex, xey) // R' = G * s + X * e
+
+	expectedChallenge := computeChallenge(
+		proofContext,
+		publicKey.X,
+		publicKey.Y,
+		rx,
+		ry,
+	)
+
+	return expectedChallenge.Cmp(p.challenge) == 0
+}
+
+// Marshal turns a `DiscreteLogProof` into a slice of bytes. The challenge and
+// the response are serialized as fixed-length 32-byte big-endian values, in
+// this order. It fails if either of them is not set or is out of the range
+// of the curve order.
+func (p *DiscreteLogProof) Marshal() ([]byte, error) {
+	if !isValidScalar(p.challenge) {
+		return nil, fmt.Errorf("invalid challenge [%v]", p.challenge)
+	}
+	if !isValidScalar(p.response) {
+		return nil, fmt.Errorf("invalid response [%v]", p.response)
+	}
+
+	bytes := make([]byte, 2*scalarLength)
+
+	challengeBytes := p.challenge.Bytes()
+	copy(bytes[scalarLength-len(challengeBytes):scalarLength], challengeBytes)
+
+	responseBytes := p.response.Bytes()
+	copy(bytes[2*scalarLength-len(responseBytes):], responseBytes)
+
+	return bytes, nil
+}
+
+// Unmarshal turns a slice of bytes produced by Marshal into
+// a `DiscreteLogProof`. Both the challenge and the response have to be lower
+// than the order of the curve.
+func (p *DiscreteLogProof) Unmarshal(bytes []byte) error {
+	if len(bytes) != 2*scalarLength {
+		return fmt.Errorf(
+			"invalid proof length [%v]; expected [%v]",
+			len(bytes),
+			2*scalarLength,
+		)
+	}
+
+	challenge := new(big.Int).SetBytes(bytes[:scalarLength])
+	response := new(big.Int).SetBytes(bytes[scalarLength:])
+
+	order := btcec.S256().N
+	if challenge.Cmp(order) >= 0 {
+		return fmt.Errorf("challenge is not lower than the curve order")
+	}
+	if response.Cmp(order) >= 0 {
+		return fmt.Errorf("response is not lower than the curve order")
+	}
+
+	p.challenge = challenge
+	p.response = response
+
+	return nil
+}
+
+// computeChallenge computes the Fiat-Shamir challenge
+// `H(context || X || R) mod n`.
+// Both points are serialized in their fixed-length compressed form, so the
+// variable-length context can not be confused with them.
+func computeChallenge(proofContext []byte, xx, xy, rx, ry *big.Int) *big.Int {
+	curve := btcec.S256()
+
+	x := &btcec.PublicKey{Curve: curve, X: xx, Y: xy}
+	r := &btcec.PublicKey{Curve: curve, X: rx, Y: ry}
+
+	hash := sha256.New()
+	hash.Write(proofContext)
+	hash.Write(x.SerializeCompressed())
+	hash.Write(r.SerializeCompressed())
+
+	return new(big.Int).Mod(
+		new(big.Int).SetBytes(hash.Sum(nil)),
+		curve.N,
+	)
+}
+
+// isValidScalar checks whether the given value is set and lies in range
+// `[0, n)` where `n` is the order of the secp256k1 curve.
+func isValidScalar(value *big.Int) bool {
+	return value != nil && value.Sign() >= 0 && value.Cmp(btcec.S256().N) < 0
+}
+
+// randomScalar returns a random value in range `(0, n)` where `n` is the
+// order of the secp256k1 curve.
+func randomScalar() (*big.Int, error) {
+	for {
+		k, err := rand.Int(rand.Reader, btcec.S256().N)
+		if err != nil {
+			return nil, err
+		}
+		if k.Sign() > 0 {
+			return k, nil
+		}
+	}
+}
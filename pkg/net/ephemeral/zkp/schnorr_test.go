@@ -0,0 +1,317 @@
+package zkp
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+)
+
+func TestDiscreteLogProofVerification(t *testing.T) {
+	keyPair, err := ephemeral.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKeyPair, err := ephemeral.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proofContext := []byte("member 1, session 0x01")
+
+	var tests = map[string]struct {
+		modifyProof    func(proof *DiscreteLogProof)
+		publicKey      *ephemeral.PublicKey
+		proofContext   []byte
+		expectedResult bool
+	}{
+		"positive validation": {
+			publicKey:      keyPair.PublicKey,
+			proofContext:   proofContext,
+			expectedResult: true,
+		},
+		"negative validation - other public key": {
+			publicKey:      otherKeyPair.PublicKey,
+			proofContext:   proofContext,
+			expectedResult: false,
+		},
+		"negative validation - other context": {
+			publicKey:      keyPair.PublicKey,
+			proofContext:   []byte("member 2, session 0x01"),
+			expectedResult: false,
+		},
+		"negative validation - modified challenge": {
+			modifyProof: func(proof *DiscreteLogProof) {
+				proof.challenge = new(big.Int).Add(proof.challenge, big.NewInt(1))
+			},
+			publicKey:      keyPair.PublicKey,
+			proofContext:   proofContext,
+			expectedResult: false,
+		},
+		"negative validation - modified response": {
+			modifyProof: func(proof *DiscreteLogProof) {
+				proof.response = new(big.Int).Add(proof.response, big.NewInt(1))
+			},
+			publicKey:      keyPair.PublicKey,
+			proofContext:   proofContext,
+			expectedResult: false,
+		},
+		"negative validation - response out of range": {
+			modifyProof: func(proof *DiscreteLogProof) {
+				proof.response = new(big.Int).Add(proof.response, btcec.S256().N)
+			},
+			publicKey:      keyPair.PublicKey,
+			proofContext:   proofContext,
+			expectedResult: false,
+		},
+		"negative validation - nil response": {
+			modifyProof: func(proof *DiscreteLogProof) {
+				proof.response = nil
+			},
+			publicKey:      keyPair.PublicKey,
+			proofContext:   proofContext,
+			expectedResult: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			proof, err := ProveDiscreteLog(keyPair.PrivateKey, proofContext)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.modifyProof != nil {
+				test.modifyProof(proof)
+			}
+
+			result := proof.Verify(test.publicKey, test.proofContext)
+			if result != test.expectedResult {
+				t.Fatalf(
+					"unexpected verification result\nexpected: %v\nactual:   %v",
+					test.expectedResult,
+					result,
+				)
+			}
+		})
+	}
+}
+
+func TestDiscreteLogProofVerificationMalformedPublicKey(t *testing.T) {
+	keyPair, err := ephemeral.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proofContext := []byte("member 1, session 0x01")
+
+	proof, err := ProveDiscreteLog(keyPair.PrivateKey, proofContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		publicKey *ephemeral.PublicKey
+	}{
+		"nil public key": {
+			publicKey: nil,
+		},
+		"nil X coordinate": {
+			publicKey: &ephemeral.PublicKey{
+				Curve: btcec.S256(),
+				Y:     keyPair.PublicKey.Y,
+			},
+		},
+		"nil Y coordinate": {
+			publicKey: &ephemeral.PublicKey{
+				Curve: btcec.S256(),
+				X:     keyPair.PublicKey.X,
+			},
+		},
+		"point not on curve": {
+			publicKey: &ephemeral.PublicKey{
+				Curve: btcec.S256(),
+				X:     keyPair.PublicKey.X,
+				Y:     new(big.Int).Add(keyPair.PublicKey.Y, big.NewInt(1)),
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if proof.Verify(test.publicKey, proofContext) {
+				t.Fatal("expected verification to fail")
+			}
+		})
+	}
+}
+
+func TestDiscreteLogProofMarshalling(t *testing.T) {
+	keyPair, err := ephemeral.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proofContext := []byte("member 1, session 0x01")
+
+	proof, err := ProveDiscreteLog(keyPair.PrivateKey, proofContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bytes, err := proof.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bytes) != 64 {
+		t.Fatalf(
+			"unexpected proof length\nexpected: %v\nactual:   %v",
+			64,
+			len(bytes),
+		)
+	}
+
+	unmarshalled := &DiscreteLogProof{}
+	if err := unmarshalled.Unmarshal(bytes); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(proof, unmarshalled) {
+		t.Fatalf(
+			"unexpected unmarshalled proof\nexpected: %v\nactual:   %v",
+			proof,
+			unmarshalled,
+		)
+	}
+
+	if !unmarshalled.Verify(keyPair.PublicKey, proofContext) {
+		t.Fatal("unmarshalled proof does not verify")
+	}
+}
+
+func TestDiscreteLogProofMarshallingShortScalars(t *testing.T) {
+	proof := &DiscreteLogProof{
+		challenge: big.NewInt(1),
+		response:  big.NewInt(0),
+	}
+
+	bytes, err := proof.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unmarshalled := &DiscreteLogProof{}
+	if err := unmarshalled.Unmarshal(bytes); err != nil {
+		t.Fatal(err)
+	}
+
+	if unmarshalled.challenge.Cmp(proof.challenge) != 0 ||
+		unmarshalled.response.Cmp(proof.response) != 0 {
+		t.Fatalf(
+			"unexpected unmarshalled proof\nexpected: %v\nactual:   %v",
+			proof,
+			unmarshalled,
+		)
+	}
+}
+
+func TestDiscreteLogProofUnmarshalValidation(t *testing.T) {
+	order := btcec.S256().N.Bytes()
+	zero := make([]byte, 32)
+
+	var tests = map[string]struct {
+		bytes         []byte
+		expectedError error
+	}{
+		"too short": {
+			bytes:         make([]byte, 63),
+			expectedError: fmt.Errorf("invalid proof length [63]; expected [64]"),
+		},
+		"too long": {
+			bytes:         make([]byte, 65),
+			expectedError: fmt.Errorf("invalid proof length [65]; expected [64]"),
+		},
+		"challenge equal to curve order": {
+			bytes:         append(append([]byte{}, order...), zero...),
+			expectedError: fmt.Errorf("challenge is not lower than the curve order"),
+		},
+		"response equal to curve order": {
+			bytes:         append(append([]byte{}, zero...), order...),
+			expectedError: fmt.Errorf("response is not lower than the curve order"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := (&DiscreteLogProof{}).Unmarshal(test.bytes)
+			if !reflect.DeepEqual(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestDiscreteLogProofMarshalValidation(t *testing.T) {
+	order := btcec.S256().N
+
+	var tests = map[string]struct {
+		proof         *DiscreteLogProof
+		expectedError error
+	}{
+		"zero value proof": {
+			proof:         &DiscreteLogProof{},
+			expectedError: fmt.Errorf("invalid challenge [<nil>]"),
+		},
+		"nil response": {
+			proof: &DiscreteLogProof{
+				challenge: big.NewInt(1),
+			},
+			expectedError: fmt.Errorf("invalid response [<nil>]"),
+		},
+		"challenge equal to curve order": {
+			proof: &DiscreteLogProof{
+				challenge: order,
+				response:  big.NewInt(1),
+			},
+			expectedError: fmt.Errorf("invalid challenge [%v]", order),
+		},
+		"response longer than 32 bytes": {
+			proof: &DiscreteLogProof{
+				challenge: big.NewInt(1),
+				response:  new(big.Int).Lsh(big.NewInt(1), 256),
+			},
+			expectedError: fmt.Errorf(
+				"invalid response [%v]",
+				new(big.Int).Lsh(big.NewInt(1), 256),
+			),
+		},
+		"negative response": {
+			proof: &DiscreteLogProof{
+				challenge: big.NewInt(1),
+				response:  big.NewInt(-1),
+			},
+			expectedError: fmt.Errorf("invalid response [-1]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := test.proof.Marshal()
+			if !reflect.DeepEqual(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}